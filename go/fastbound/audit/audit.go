@@ -0,0 +1,154 @@
+// Package audit records outbound FastBound API calls as a tamper-evident,
+// hash-chained JSON log, since FFL bound-book activity is regulated data.
+package audit
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/FastBound/Support/go/fastbound"
+)
+
+// Record is one audit log entry. PrevHash links it to the prior record in
+// the chain and Hash commits to everything else in the record, so a
+// deleted or edited record breaks the chain at Verify time.
+type Record struct {
+	Time           time.Time   `json:"time"`
+	Method         string      `json:"method"`
+	URL            string      `json:"url"`
+	Headers        http.Header `json:"headers"`
+	RequestBody    string      `json:"request_body,omitempty"`
+	StatusCode     int         `json:"status_code"`
+	ResponseBody   string      `json:"response_body,omitempty"`
+	LatencyMS      int64       `json:"latency_ms"`
+	IdempotencyKey string      `json:"idempotency_key,omitempty"`
+	Principal      string      `json:"principal,omitempty"`
+	PrevHash       string      `json:"prev_hash"`
+	Hash           string      `json:"hash"`
+}
+
+// RedactFunc strips sensitive fields (e.g. TransfereeEmails, Note) from a
+// Record before it is persisted.
+type RedactFunc func(Record) Record
+
+// Sink persists a finished Record. File, syslog, stdout, and io.Writer
+// implementations are provided by NewFileSink, NewSyslogSink, and
+// NewWriterSink.
+type Sink interface {
+	Write(Record) error
+}
+
+// Logger implements fastbound.AuditRecorder, writing a hash-chained
+// Record to Sink for every AuditEvent it receives.
+type Logger struct {
+	sink   Sink
+	redact RedactFunc
+
+	mu       sync.Mutex
+	prevHash string
+}
+
+// Option configures a Logger constructed by NewLogger.
+type Option func(*Logger)
+
+// WithRedaction sets the RedactFunc applied to each Record before it is
+// hashed and written.
+func WithRedaction(f RedactFunc) Option {
+	return func(l *Logger) {
+		l.redact = f
+	}
+}
+
+// NewLogger constructs a Logger that writes to sink.
+func NewLogger(sink Sink, opts ...Option) *Logger {
+	l := &Logger{sink: sink}
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l
+}
+
+// Record implements fastbound.AuditRecorder.
+func (l *Logger) Record(event fastbound.AuditEvent) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	rec := Record{
+		Time:           time.Now(),
+		Method:         event.Method,
+		URL:            event.URL,
+		Headers:        event.Headers,
+		RequestBody:    string(event.RequestBody),
+		StatusCode:     event.StatusCode,
+		ResponseBody:   string(event.ResponseBody),
+		LatencyMS:      event.Latency.Milliseconds(),
+		IdempotencyKey: event.IdempotencyKey,
+		Principal:      event.Principal,
+	}
+	if l.redact != nil {
+		rec = l.redact(rec)
+	}
+
+	rec.PrevHash = l.prevHash
+	rec.Hash = hashRecord(rec)
+
+	if err := l.sink.Write(rec); err != nil {
+		return fmt.Errorf("audit: write record: %w", err)
+	}
+	l.prevHash = rec.Hash
+
+	return nil
+}
+
+// hashRecord computes sha256(PrevHash || canonical_json(record)), with
+// Hash itself cleared so the record commits to everything but its own
+// hash.
+func hashRecord(rec Record) string {
+	rec.Hash = ""
+	data, err := json.Marshal(rec)
+	if err != nil {
+		// Record contains only marshalable fields; this cannot happen.
+		panic(fmt.Sprintf("audit: marshal record: %v", err))
+	}
+
+	h := sha256.New()
+	h.Write([]byte(rec.PrevHash))
+	h.Write(data)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Verify walks a hash-chained log written by a Logger and returns an error
+// describing the first broken link it finds, or nil if the chain is
+// intact end to end.
+func Verify(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+
+	var prevHash string
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		var rec Record
+		if err := json.Unmarshal(scanner.Bytes(), &rec); err != nil {
+			return fmt.Errorf("audit: line %d: decode record: %w", lineNo, err)
+		}
+
+		if rec.PrevHash != prevHash {
+			return fmt.Errorf("audit: line %d: chain broken: expected prev_hash %q, found %q", lineNo, prevHash, rec.PrevHash)
+		}
+
+		wantHash := hashRecord(rec)
+		if wantHash != rec.Hash {
+			return fmt.Errorf("audit: line %d: hash mismatch: record has been tampered with", lineNo)
+		}
+
+		prevHash = rec.Hash
+	}
+
+	return scanner.Err()
+}
@@ -0,0 +1,118 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/FastBound/Support/go/fastbound"
+)
+
+func TestLoggerChainsHashes(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger(NewWriterSink(&buf))
+
+	if err := logger.Record(fastbound.AuditEvent{Method: "POST", URL: "https://cloud.fastbound.com/api/transfers"}); err != nil {
+		t.Fatalf("Record() error = %v", err)
+	}
+	if err := logger.Record(fastbound.AuditEvent{Method: "GET", URL: "https://cloud.fastbound.com/api/transfers/t1"}); err != nil {
+		t.Fatalf("Record() error = %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d log lines, want 2", len(lines))
+	}
+
+	var first, second Record
+	if err := json.Unmarshal([]byte(lines[0]), &first); err != nil {
+		t.Fatalf("decode first record: %v", err)
+	}
+	if err := json.Unmarshal([]byte(lines[1]), &second); err != nil {
+		t.Fatalf("decode second record: %v", err)
+	}
+
+	if first.PrevHash != "" {
+		t.Errorf("first.PrevHash = %q, want empty", first.PrevHash)
+	}
+	if first.Hash == "" {
+		t.Error("first.Hash is empty, want non-empty")
+	}
+	if second.PrevHash != first.Hash {
+		t.Errorf("second.PrevHash = %q, want %q", second.PrevHash, first.Hash)
+	}
+
+	if err := Verify(bytes.NewReader(buf.Bytes())); err != nil {
+		t.Errorf("Verify() error = %v, want nil", err)
+	}
+}
+
+func TestVerifyDetectsTampering(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger(NewWriterSink(&buf))
+
+	for i := 0; i < 3; i++ {
+		if err := logger.Record(fastbound.AuditEvent{Method: "GET", URL: "https://cloud.fastbound.com/api/transfers/t1"}); err != nil {
+			t.Fatalf("Record() error = %v", err)
+		}
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	var middle Record
+	if err := json.Unmarshal([]byte(lines[1]), &middle); err != nil {
+		t.Fatalf("decode middle record: %v", err)
+	}
+	middle.ResponseBody = "tampered"
+	tampered, err := json.Marshal(middle)
+	if err != nil {
+		t.Fatalf("re-marshal tampered record: %v", err)
+	}
+	lines[1] = string(tampered)
+
+	if err := Verify(strings.NewReader(strings.Join(lines, "\n"))); err == nil {
+		t.Fatal("Verify() error = nil, want error on tampered chain")
+	}
+}
+
+func TestVerifyDetectsDeletedRecord(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger(NewWriterSink(&buf))
+
+	for i := 0; i < 3; i++ {
+		if err := logger.Record(fastbound.AuditEvent{Method: "GET", URL: "https://cloud.fastbound.com/api/transfers/t1"}); err != nil {
+			t.Fatalf("Record() error = %v", err)
+		}
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	withoutMiddle := []string{lines[0], lines[2]}
+
+	if err := Verify(strings.NewReader(strings.Join(withoutMiddle, "\n"))); err == nil {
+		t.Fatal("Verify() error = nil, want error when a record is deleted from the chain")
+	}
+}
+
+func TestRedactionAppliesBeforeHashing(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger(NewWriterSink(&buf), WithRedaction(func(r Record) Record {
+		r.RequestBody = "REDACTED"
+		return r
+	}))
+
+	if err := logger.Record(fastbound.AuditEvent{RequestBody: []byte(`{"note":"secret"}`)}); err != nil {
+		t.Fatalf("Record() error = %v", err)
+	}
+
+	var rec Record
+	if err := json.Unmarshal(buf.Bytes(), &rec); err != nil {
+		t.Fatalf("decode record: %v", err)
+	}
+	if rec.RequestBody != "REDACTED" {
+		t.Errorf("RequestBody = %q, want REDACTED", rec.RequestBody)
+	}
+
+	if err := Verify(bytes.NewReader(buf.Bytes())); err != nil {
+		t.Errorf("Verify() error = %v, want nil (hash must commit to the redacted record)", err)
+	}
+}
@@ -0,0 +1,38 @@
+//go:build !windows
+
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/syslog"
+)
+
+// SyslogSink writes each Record as a JSON line to the local syslog daemon
+// at LOG_INFO, tagged with the given process name.
+type SyslogSink struct {
+	w *syslog.Writer
+}
+
+// NewSyslogSink connects to the local syslog daemon.
+func NewSyslogSink(tag string) (*SyslogSink, error) {
+	w, err := syslog.New(syslog.LOG_INFO, tag)
+	if err != nil {
+		return nil, fmt.Errorf("audit: connect to syslog: %w", err)
+	}
+	return &SyslogSink{w: w}, nil
+}
+
+// Write implements Sink.
+func (s *SyslogSink) Write(rec Record) error {
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("audit: marshal record: %w", err)
+	}
+	return s.w.Info(string(data))
+}
+
+// Close closes the syslog connection.
+func (s *SyslogSink) Close() error {
+	return s.w.Close()
+}
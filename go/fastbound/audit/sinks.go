@@ -0,0 +1,64 @@
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// WriterSink writes each Record as a JSON line to an io.Writer.
+type WriterSink struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewWriterSink wraps an arbitrary io.Writer as a Sink.
+func NewWriterSink(w io.Writer) *WriterSink {
+	return &WriterSink{w: w}
+}
+
+// Write implements Sink.
+func (s *WriterSink) Write(rec Record) error {
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("audit: marshal record: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.w.Write(append(data, '\n'))
+	return err
+}
+
+// NewStdoutSink writes each Record as a JSON line to os.Stdout.
+func NewStdoutSink() *WriterSink {
+	return NewWriterSink(os.Stdout)
+}
+
+// FileSink appends each Record as a JSON line to a file.
+type FileSink struct {
+	inner *WriterSink
+	f     *os.File
+}
+
+// NewFileSink opens (creating if necessary) path for appending and
+// returns a Sink backed by it. Call Close when done logging.
+func NewFileSink(path string) (*FileSink, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("audit: open log file: %w", err)
+	}
+	return &FileSink{inner: NewWriterSink(f), f: f}, nil
+}
+
+// Write implements Sink.
+func (s *FileSink) Write(rec Record) error {
+	return s.inner.Write(rec)
+}
+
+// Close closes the underlying file.
+func (s *FileSink) Close() error {
+	return s.f.Close()
+}
@@ -0,0 +1,25 @@
+package fastbound
+
+import (
+	"net/http"
+	"time"
+)
+
+// AuditEvent describes one outbound API call, passed to an AuditRecorder.
+type AuditEvent struct {
+	Method         string
+	URL            string
+	Headers        http.Header
+	RequestBody    []byte
+	StatusCode     int
+	ResponseBody   []byte
+	Latency        time.Duration
+	IdempotencyKey string
+	Principal      string
+}
+
+// AuditRecorder records an AuditEvent for every outbound API call. The
+// audit subpackage provides a hash-chained JSON implementation.
+type AuditRecorder interface {
+	Record(AuditEvent) error
+}
@@ -0,0 +1,74 @@
+package fastbound
+
+import "net/http"
+
+// Authenticator applies credentials to an outgoing request. Implementations
+// must be safe for concurrent use, since a Client may be shared across
+// goroutines.
+type Authenticator interface {
+	Authenticate(req *http.Request) error
+}
+
+// principalAuthenticator is implemented by Authenticators that can report
+// the identity they authenticate as, for inclusion in audit records. It is
+// deliberately distinct from any secret the Authenticator holds.
+type principalAuthenticator interface {
+	Principal() string
+}
+
+// sensitiveHeaderAuthenticator is implemented by Authenticators that set
+// credential headers other than the default "Authorization", so audit
+// logging knows which additional headers to redact.
+type sensitiveHeaderAuthenticator interface {
+	SensitiveHeaders() []string
+}
+
+// BasicAuth authenticates requests with HTTP Basic auth.
+type BasicAuth struct {
+	Username string
+	Password string
+}
+
+// Authenticate implements Authenticator.
+func (b BasicAuth) Authenticate(req *http.Request) error {
+	req.SetBasicAuth(b.Username, b.Password)
+	return nil
+}
+
+// Principal implements principalAuthenticator.
+func (b BasicAuth) Principal() string {
+	return b.Username
+}
+
+// APIKey authenticates requests with a header carrying a bearer-style API
+// key. Header defaults to "Authorization" with a "Bearer " prefix when
+// empty. ID, if set, identifies which key is in use (e.g. for audit
+// logging) without revealing the secret Key itself.
+type APIKey struct {
+	ID     string
+	Key    string
+	Header string
+}
+
+// Authenticate implements Authenticator.
+func (a APIKey) Authenticate(req *http.Request) error {
+	if a.Header == "" {
+		req.Header.Set("Authorization", "Bearer "+a.Key)
+		return nil
+	}
+	req.Header.Set(a.Header, a.Key)
+	return nil
+}
+
+// Principal implements principalAuthenticator.
+func (a APIKey) Principal() string {
+	return a.ID
+}
+
+// SensitiveHeaders implements sensitiveHeaderAuthenticator.
+func (a APIKey) SensitiveHeaders() []string {
+	if a.Header == "" {
+		return nil
+	}
+	return []string{a.Header}
+}
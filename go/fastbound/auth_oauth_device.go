@@ -0,0 +1,295 @@
+package fastbound
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Errors returned while polling the token endpoint, modeled on the error
+// codes defined by RFC 8628 section 3.5.
+var (
+	ErrAccessDenied = errors.New("fastbound: oauth device flow: access_denied")
+	ErrExpiredToken = errors.New("fastbound: oauth device flow: device code expired")
+)
+
+// errAuthorizationPending and errSlowDown are handled internally by the
+// polling loop and never returned to callers.
+var (
+	errAuthorizationPending = errors.New("authorization_pending")
+	errSlowDown             = errors.New("slow_down")
+)
+
+// DeviceCodeResponse is the response from the device authorization
+// endpoint, per RFC 8628 section 3.2.
+type DeviceCodeResponse struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete,omitempty"`
+	ExpiresIn               int    `json:"expires_in"`
+	Interval                int    `json:"interval"`
+}
+
+// OAuthDeviceAuth authenticates requests using the OAuth 2.0 device
+// authorization grant (RFC 8628). On first use it walks the operator
+// through authorizing the client in a browser, then keeps the resulting
+// token refreshed for the lifetime of the process.
+type OAuthDeviceAuth struct {
+	ClientID               string
+	Scope                  string
+	DeviceAuthorizationURL string
+	TokenURL               string
+
+	// HTTPClient is used for the device authorization and token requests.
+	// Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+
+	// Store persists the token across runs. Defaults to an in-memory-only
+	// store (i.e. re-authorization is required every process start) if nil.
+	Store TokenStore
+
+	// Prompt is called with the device code details so the operator can be
+	// directed to authorize the client. Defaults to printing to stdout.
+	Prompt func(DeviceCodeResponse)
+
+	mu    sync.Mutex
+	token *Token
+}
+
+// Authenticate implements Authenticator. It obtains a token via the device
+// flow on first use, refreshing or re-authorizing as needed, and sets the
+// request's Authorization header.
+func (o *OAuthDeviceAuth) Authenticate(req *http.Request) error {
+	token, err := o.ensureToken(req.Context())
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	return nil
+}
+
+func (o *OAuthDeviceAuth) ensureToken(ctx context.Context) (*Token, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.token == nil && o.Store != nil {
+		stored, err := o.Store.Load()
+		if err != nil {
+			return nil, err
+		}
+		o.token = stored
+	}
+
+	if o.token != nil && o.token.Valid() {
+		return o.token, nil
+	}
+
+	var (
+		token *Token
+		err   error
+	)
+	if o.token != nil && o.token.RefreshToken != "" {
+		// A usable refresh token exists: only the interactive device flow
+		// falls back to prompting. A refresh failure (network blip, 5xx,
+		// cancellation) is a real error callers should see, not a cue to
+		// silently block on human approval that may never come.
+		token, err = o.refreshToken(ctx, o.token.RefreshToken)
+	} else {
+		token, err = o.authorize(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	o.token = token
+	if o.Store != nil {
+		if err := o.Store.Save(*token); err != nil {
+			return nil, err
+		}
+	}
+	return o.token, nil
+}
+
+// authorize runs the full device authorization grant: request a device
+// code, prompt the operator, then poll the token endpoint until the user
+// approves, denies, or the device code expires.
+func (o *OAuthDeviceAuth) authorize(ctx context.Context) (*Token, error) {
+	dcr, err := o.requestDeviceCode(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if o.Prompt != nil {
+		o.Prompt(*dcr)
+	} else {
+		fmt.Printf("To authorize this application, visit %s and enter code: %s\n", dcr.VerificationURI, dcr.UserCode)
+	}
+
+	interval := time.Duration(dcr.Interval) * time.Second
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+	deadline := time.Now().Add(time.Duration(dcr.ExpiresIn) * time.Second)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+
+		if time.Now().After(deadline) {
+			return nil, ErrExpiredToken
+		}
+
+		token, err := o.pollToken(ctx, dcr.DeviceCode)
+		switch {
+		case errors.Is(err, errAuthorizationPending):
+			continue
+		case errors.Is(err, errSlowDown):
+			interval += 5 * time.Second
+			continue
+		case err != nil:
+			return nil, err
+		default:
+			return token, nil
+		}
+	}
+}
+
+func (o *OAuthDeviceAuth) requestDeviceCode(ctx context.Context) (*DeviceCodeResponse, error) {
+	form := url.Values{
+		"client_id": {o.ClientID},
+		"scope":     {o.Scope},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, o.DeviceAuthorizationURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := o.httpClient().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, &APIError{StatusCode: resp.StatusCode, Body: body}
+	}
+
+	var dcr DeviceCodeResponse
+	if err := json.Unmarshal(body, &dcr); err != nil {
+		return nil, fmt.Errorf("fastbound: decode device authorization response: %w", err)
+	}
+	return &dcr, nil
+}
+
+// tokenErrorResponse is the error shape returned by the token endpoint per
+// RFC 6749 section 5.2.
+type tokenErrorResponse struct {
+	Error string `json:"error"`
+}
+
+type tokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int    `json:"expires_in"`
+}
+
+func (o *OAuthDeviceAuth) pollToken(ctx context.Context, deviceCode string) (*Token, error) {
+	form := url.Values{
+		"grant_type":  {"urn:ietf:params:oauth:grant-type:device_code"},
+		"device_code": {deviceCode},
+		"client_id":   {o.ClientID},
+	}
+	return o.requestToken(ctx, form, "")
+}
+
+func (o *OAuthDeviceAuth) refreshToken(ctx context.Context, refreshToken string) (*Token, error) {
+	form := url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {refreshToken},
+		"client_id":     {o.ClientID},
+	}
+	return o.requestToken(ctx, form, refreshToken)
+}
+
+// requestToken posts form to the token endpoint. priorRefreshToken is the
+// refresh token used to obtain this response (empty for the initial device
+// grant); per RFC 6749 section 6, many authorization servers omit
+// refresh_token from a refresh response and expect the client to keep
+// reusing the one it already has.
+func (o *OAuthDeviceAuth) requestToken(ctx context.Context, form url.Values, priorRefreshToken string) (*Token, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, o.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := o.httpClient().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var tokenErr tokenErrorResponse
+		if err := json.Unmarshal(body, &tokenErr); err == nil {
+			switch tokenErr.Error {
+			case "authorization_pending":
+				return nil, errAuthorizationPending
+			case "slow_down":
+				return nil, errSlowDown
+			case "access_denied":
+				return nil, ErrAccessDenied
+			case "expired_token":
+				return nil, ErrExpiredToken
+			}
+		}
+		return nil, &APIError{StatusCode: resp.StatusCode, Body: body}
+	}
+
+	var tr tokenResponse
+	if err := json.Unmarshal(body, &tr); err != nil {
+		return nil, fmt.Errorf("fastbound: decode token response: %w", err)
+	}
+
+	refreshToken := tr.RefreshToken
+	if refreshToken == "" {
+		refreshToken = priorRefreshToken
+	}
+
+	return &Token{
+		AccessToken:  tr.AccessToken,
+		RefreshToken: refreshToken,
+		TokenType:    tr.TokenType,
+		ExpiresAt:    time.Now().Add(time.Duration(tr.ExpiresIn) * time.Second),
+	}, nil
+}
+
+func (o *OAuthDeviceAuth) httpClient() *http.Client {
+	if o.HTTPClient != nil {
+		return o.HTTPClient
+	}
+	return http.DefaultClient
+}
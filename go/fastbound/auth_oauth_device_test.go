@@ -0,0 +1,263 @@
+package fastbound
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestPollTokenErrorMapping(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+		wantErr    error
+	}{
+		{"authorization_pending", http.StatusBadRequest, `{"error":"authorization_pending"}`, errAuthorizationPending},
+		{"slow_down", http.StatusBadRequest, `{"error":"slow_down"}`, errSlowDown},
+		{"access_denied", http.StatusBadRequest, `{"error":"access_denied"}`, ErrAccessDenied},
+		{"expired_token", http.StatusBadRequest, `{"error":"expired_token"}`, ErrExpiredToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.statusCode)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer srv.Close()
+
+			o := &OAuthDeviceAuth{ClientID: "client-1", TokenURL: srv.URL}
+			_, err := o.pollToken(context.Background(), "device-code")
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("pollToken() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestOAuthDeviceAuthAuthorizesOnFirstUse(t *testing.T) {
+	var pollAttempts int32
+	var promptedCode string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/device_authorization", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(DeviceCodeResponse{
+			DeviceCode:      "devcode-1",
+			UserCode:        "ABCD-1234",
+			VerificationURI: "https://example.com/device",
+			ExpiresIn:       30,
+			Interval:        1,
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&pollAttempts, 1) == 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"authorization_pending"}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(tokenResponse{
+			AccessToken:  "access-1",
+			RefreshToken: "refresh-1",
+			TokenType:    "Bearer",
+			ExpiresIn:    3600,
+		})
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	o := &OAuthDeviceAuth{
+		ClientID:               "client-1",
+		DeviceAuthorizationURL: srv.URL + "/device_authorization",
+		TokenURL:               srv.URL + "/token",
+		Prompt: func(dcr DeviceCodeResponse) {
+			promptedCode = dcr.UserCode
+		},
+	}
+
+	token, err := o.ensureToken(context.Background())
+	if err != nil {
+		t.Fatalf("ensureToken() error = %v", err)
+	}
+	if token.AccessToken != "access-1" {
+		t.Errorf("AccessToken = %q, want access-1", token.AccessToken)
+	}
+	if promptedCode != "ABCD-1234" {
+		t.Errorf("prompted code = %q, want ABCD-1234", promptedCode)
+	}
+	if got := atomic.LoadInt32(&pollAttempts); got != 2 {
+		t.Errorf("poll attempts = %d, want 2 (one pending, one success)", got)
+	}
+}
+
+func TestOAuthDeviceAuthRefreshesExistingToken(t *testing.T) {
+	var deviceAuthCalls, refreshCalls int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/device_authorization", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&deviceAuthCalls, 1)
+		_ = json.NewEncoder(w).Encode(DeviceCodeResponse{DeviceCode: "unexpected", Interval: 1, ExpiresIn: 30})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&refreshCalls, 1)
+		_ = json.NewEncoder(w).Encode(tokenResponse{
+			AccessToken: "access-2",
+			TokenType:   "Bearer",
+			ExpiresIn:   3600,
+		})
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	o := &OAuthDeviceAuth{
+		ClientID:               "client-1",
+		DeviceAuthorizationURL: srv.URL + "/device_authorization",
+		TokenURL:               srv.URL + "/token",
+		token:                  &Token{AccessToken: "expired", RefreshToken: "refresh-1", ExpiresAt: time.Now().Add(-time.Hour)},
+	}
+
+	token, err := o.ensureToken(context.Background())
+	if err != nil {
+		t.Fatalf("ensureToken() error = %v", err)
+	}
+	if token.AccessToken != "access-2" {
+		t.Errorf("AccessToken = %q, want access-2", token.AccessToken)
+	}
+	// The refresh response omitted refresh_token; the prior one must be kept.
+	if token.RefreshToken != "refresh-1" {
+		t.Errorf("RefreshToken = %q, want refresh-1 preserved", token.RefreshToken)
+	}
+	if got := atomic.LoadInt32(&refreshCalls); got != 1 {
+		t.Errorf("refresh calls = %d, want 1", got)
+	}
+	if got := atomic.LoadInt32(&deviceAuthCalls); got != 0 {
+		t.Errorf("device authorization calls = %d, want 0 (must not fall back to interactive auth)", got)
+	}
+}
+
+func TestOAuthDeviceAuthRefreshFailurePropagates(t *testing.T) {
+	var deviceAuthCalls int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/device_authorization", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&deviceAuthCalls, 1)
+		_ = json.NewEncoder(w).Encode(DeviceCodeResponse{DeviceCode: "unexpected", Interval: 1, ExpiresIn: 30})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	o := &OAuthDeviceAuth{
+		ClientID:               "client-1",
+		DeviceAuthorizationURL: srv.URL + "/device_authorization",
+		TokenURL:               srv.URL + "/token",
+		token:                  &Token{AccessToken: "expired", RefreshToken: "refresh-1", ExpiresAt: time.Now().Add(-time.Hour)},
+	}
+
+	_, err := o.ensureToken(context.Background())
+	if err == nil {
+		t.Fatal("ensureToken() error = nil, want the refresh failure to propagate")
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Errorf("ensureToken() error = %T, want *APIError", err)
+	}
+	if got := atomic.LoadInt32(&deviceAuthCalls); got != 0 {
+		t.Errorf("device authorization calls = %d, want 0 (a transient refresh failure must not trigger an interactive prompt)", got)
+	}
+}
+
+func TestOAuthDeviceAuthTokenStoreRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	store := &FileTokenStore{Path: filepath.Join(dir, "token.json")}
+
+	want := Token{AccessToken: "access-1", RefreshToken: "refresh-1", ExpiresAt: time.Now().Add(time.Hour)}
+	if err := store.Save(want); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	info, err := os.Stat(store.Path)
+	if err != nil {
+		t.Fatalf("Stat() error = %v", err)
+	}
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Errorf("file mode = %o, want 0600", perm)
+	}
+
+	got, err := store.Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if got.AccessToken != want.AccessToken || got.RefreshToken != want.RefreshToken {
+		t.Errorf("Load() = %+v, want %+v", got, want)
+	}
+}
+
+func TestOAuthDeviceAuthUsesStoredToken(t *testing.T) {
+	dir := t.TempDir()
+	store := &FileTokenStore{Path: filepath.Join(dir, "token.json")}
+
+	valid := Token{AccessToken: "stored-access", ExpiresAt: time.Now().Add(time.Hour)}
+	if err := store.Save(valid); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	var called int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&called, 1)
+	}))
+	defer srv.Close()
+
+	o := &OAuthDeviceAuth{
+		ClientID:               "client-1",
+		DeviceAuthorizationURL: srv.URL,
+		TokenURL:               srv.URL,
+		Store:                  store,
+	}
+
+	token, err := o.ensureToken(context.Background())
+	if err != nil {
+		t.Fatalf("ensureToken() error = %v", err)
+	}
+	if token.AccessToken != "stored-access" {
+		t.Errorf("AccessToken = %q, want stored-access", token.AccessToken)
+	}
+	if got := atomic.LoadInt32(&called); got != 0 {
+		t.Errorf("network calls = %d, want 0 (a valid stored token needs no network round trip)", got)
+	}
+}
+
+func TestOAuthDeviceAuthRequestDeviceCodeSendsForm(t *testing.T) {
+	var gotForm url.Values
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		gotForm = r.PostForm
+		_ = json.NewEncoder(w).Encode(DeviceCodeResponse{DeviceCode: "dc", UserCode: "uc", Interval: 5, ExpiresIn: 60})
+	}))
+	defer srv.Close()
+
+	o := &OAuthDeviceAuth{ClientID: "client-1", Scope: "transfers:write", DeviceAuthorizationURL: srv.URL}
+	dcr, err := o.requestDeviceCode(context.Background())
+	if err != nil {
+		t.Fatalf("requestDeviceCode() error = %v", err)
+	}
+	if dcr.DeviceCode != "dc" {
+		t.Errorf("DeviceCode = %q, want dc", dcr.DeviceCode)
+	}
+	if got := gotForm.Get("client_id"); got != "client-1" {
+		t.Errorf("client_id = %q, want client-1", got)
+	}
+	if got := gotForm.Get("scope"); got != "transfers:write" {
+		t.Errorf("scope = %q, want transfers:write", got)
+	}
+}
@@ -0,0 +1,188 @@
+package fastbound
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// The helpers below independently re-derive the FB1-HMAC-SHA256 signature
+// per the scheme's own description (distinct from the unexported helpers
+// in auth_signature.go), so these tests catch a canonicalization bug in
+// the production code rather than just echoing it back.
+
+func testHMAC(key, data string) []byte {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func testSHA256Hex(data string) string {
+	sum := sha256.Sum256([]byte(data))
+	return hex.EncodeToString(sum[:])
+}
+
+func testSigningKey(secret, dateStamp string) []byte {
+	kDate := testHMAC(secret, dateStamp)
+	kService := hmac.New(sha256.New, kDate)
+	kService.Write([]byte("fastbound"))
+	kSigning := hmac.New(sha256.New, kService.Sum(nil))
+	kSigning.Write([]byte("fb_request"))
+	return kSigning.Sum(nil)
+}
+
+func fixedClock(t time.Time) func() time.Time {
+	return func() time.Time { return t }
+}
+
+func TestSignatureAuthAuthenticate(t *testing.T) {
+	when := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	const body = `{"foo":"bar"}`
+
+	req, err := http.NewRequest(http.MethodPost, "https://cloud.fastbound.com/api/transfers", strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("http.NewRequest() error = %v", err)
+	}
+
+	auth := SignatureAuth{AccessKeyID: "AKIDEXAMPLE", SecretKey: "secret", Clock: fixedClock(when)}
+	if err := auth.Authenticate(req); err != nil {
+		t.Fatalf("Authenticate() error = %v", err)
+	}
+
+	bodyHash := testSHA256Hex(body)
+	if got := req.Header.Get("X-FB-Content-Sha256"); got != bodyHash {
+		t.Errorf("X-FB-Content-Sha256 = %q, want %q", got, bodyHash)
+	}
+
+	amzDate := "20260101T000000Z"
+	if got := req.Header.Get("X-FB-Date"); got != amzDate {
+		t.Errorf("X-FB-Date = %q, want %q", got, amzDate)
+	}
+
+	signedHeaders := "host;x-fb-content-sha256;x-fb-date"
+	canonicalHeaders := fmt.Sprintf("host:cloud.fastbound.com\nx-fb-content-sha256:%s\nx-fb-date:%s\n", bodyHash, amzDate)
+	canonicalRequest := strings.Join([]string{
+		http.MethodPost, "/api/transfers", "", canonicalHeaders, signedHeaders, bodyHash,
+	}, "\n")
+
+	dateStamp := "20260101"
+	scope := dateStamp + "/fastbound/api/fb_request"
+	stringToSign := strings.Join([]string{
+		"FB1-HMAC-SHA256", amzDate, scope, testSHA256Hex(canonicalRequest),
+	}, "\n")
+
+	signature := hex.EncodeToString(testHMAC(string(testSigningKey("secret", dateStamp)), stringToSign))
+	wantAuth := fmt.Sprintf("FB1-HMAC-SHA256 Credential=AKIDEXAMPLE/%s, SignedHeaders=%s, Signature=%s", scope, signedHeaders, signature)
+
+	if got := req.Header.Get("Authorization"); got != wantAuth {
+		t.Errorf("Authorization = %q, want %q", got, wantAuth)
+	}
+}
+
+func TestSignatureAuthPreservesRequestBody(t *testing.T) {
+	const body = `{"foo":"bar"}`
+	req, err := http.NewRequest(http.MethodPost, "https://cloud.fastbound.com/api/transfers", strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("http.NewRequest() error = %v", err)
+	}
+
+	auth := SignatureAuth{AccessKeyID: "AKIDEXAMPLE", SecretKey: "secret"}
+	if err := auth.Authenticate(req); err != nil {
+		t.Fatalf("Authenticate() error = %v", err)
+	}
+
+	got, err := io.ReadAll(req.Body)
+	if err != nil {
+		t.Fatalf("ReadAll(req.Body) error = %v", err)
+	}
+	if string(got) != body {
+		t.Errorf("req.Body after Authenticate = %q, want %q", got, body)
+	}
+}
+
+// signResponseForTest builds a response signed the same way a FastBound
+// server would, for VerifyResponseSignature to validate.
+func signResponseForTest(t *testing.T, secret string, status int, body string, when time.Time) *http.Response {
+	t.Helper()
+
+	bodyHash := testSHA256Hex(body)
+	amzDate := when.Format("20060102T150405Z")
+	dateStamp := when.Format("20060102")
+
+	resp := &http.Response{
+		StatusCode: status,
+		Header:     make(http.Header),
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+	resp.Header.Set("X-FB-Content-Sha256", bodyHash)
+	resp.Header.Set("X-FB-Date", amzDate)
+
+	signedHeaders := "x-fb-content-sha256;x-fb-date"
+	canonicalHeaders := fmt.Sprintf("x-fb-content-sha256:%s\nx-fb-date:%s\n", bodyHash, amzDate)
+	canonicalResponse := strings.Join([]string{
+		fmt.Sprintf("%d", status), canonicalHeaders, signedHeaders, bodyHash,
+	}, "\n")
+
+	scope := dateStamp + "/fastbound/api/fb_request"
+	stringToSign := strings.Join([]string{
+		"FB1-HMAC-SHA256", amzDate, scope, testSHA256Hex(canonicalResponse),
+	}, "\n")
+	signature := hex.EncodeToString(testHMAC(string(testSigningKey(secret, dateStamp)), stringToSign))
+
+	resp.Header.Set("X-FB-Signature", fmt.Sprintf(
+		"FB1-HMAC-SHA256 Credential=AKIDEXAMPLE/%s, SignedHeaders=%s, Signature=%s", scope, signedHeaders, signature,
+	))
+
+	return resp
+}
+
+func TestVerifyResponseSignatureAccepts(t *testing.T) {
+	when := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	resp := signResponseForTest(t, "secret", http.StatusOK, `{"id":"t1","status":"Accepted"}`, when)
+
+	if err := VerifyResponseSignature(resp, "secret"); err != nil {
+		t.Errorf("VerifyResponseSignature() error = %v, want nil", err)
+	}
+}
+
+func TestVerifyResponseSignatureRejectsTamperedBody(t *testing.T) {
+	when := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	resp := signResponseForTest(t, "secret", http.StatusOK, `{"id":"t1","status":"Accepted"}`, when)
+	resp.Body = io.NopCloser(strings.NewReader(`{"id":"t1","status":"Rejected"}`))
+
+	if err := VerifyResponseSignature(resp, "secret"); err == nil {
+		t.Error("VerifyResponseSignature() error = nil, want error for a body swapped in transit")
+	}
+}
+
+func TestVerifyResponseSignatureRejectsTamperedStatus(t *testing.T) {
+	when := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	resp := signResponseForTest(t, "secret", http.StatusOK, `{"id":"t1","status":"Accepted"}`, when)
+	resp.StatusCode = http.StatusInternalServerError
+
+	if err := VerifyResponseSignature(resp, "secret"); err == nil {
+		t.Error("VerifyResponseSignature() error = nil, want error for a status code changed in transit")
+	}
+}
+
+func TestVerifyResponseSignatureRejectsWrongSecret(t *testing.T) {
+	when := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	resp := signResponseForTest(t, "secret", http.StatusOK, `{"id":"t1","status":"Accepted"}`, when)
+
+	if err := VerifyResponseSignature(resp, "wrong-secret"); err == nil {
+		t.Error("VerifyResponseSignature() error = nil, want error for the wrong secret")
+	}
+}
+
+func TestVerifyResponseSignatureRejectsMissingHeader(t *testing.T) {
+	resp := &http.Response{StatusCode: http.StatusOK, Header: make(http.Header), Body: http.NoBody}
+	if err := VerifyResponseSignature(resp, "secret"); err == nil {
+		t.Error("VerifyResponseSignature() error = nil, want error when X-FB-Signature is absent")
+	}
+}
@@ -0,0 +1,221 @@
+// Package fastbound provides a typed Go client for the FastBound API.
+package fastbound
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DefaultBaseURL is used when callers pass an empty base URL to NewClient.
+const DefaultBaseURL = "https://cloud.fastbound.com"
+
+// Client is a FastBound API client. Construct one with NewClient.
+type Client struct {
+	baseURL    *url.URL
+	httpClient *http.Client
+	auth       Authenticator
+	validator  Validator
+	auditor    AuditRecorder
+
+	// Transfers exposes the transfers resource.
+	Transfers *TransfersService
+}
+
+// Option configures a Client constructed by NewClient.
+type Option func(*Client)
+
+// WithHTTPClient overrides the *http.Client used to send requests. The
+// default is http.DefaultClient.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = hc
+	}
+}
+
+// WithAuthenticator sets the Authenticator used to sign outgoing requests.
+// Without one, requests are sent unauthenticated.
+func WithAuthenticator(a Authenticator) Option {
+	return func(c *Client) {
+		c.auth = a
+	}
+}
+
+// WithValidator sets the Validator run against every TransferPayload
+// before it is submitted by Transfers.Create.
+func WithValidator(v Validator) Option {
+	return func(c *Client) {
+		c.validator = v
+	}
+}
+
+// WithAuditRecorder sets the AuditRecorder that records every outbound API
+// call. The audit subpackage provides a hash-chained JSON implementation.
+func WithAuditRecorder(r AuditRecorder) Option {
+	return func(c *Client) {
+		c.auditor = r
+	}
+}
+
+// NewClient constructs a Client for the given base URL, e.g.
+// "https://cloud.fastbound.com". An empty baseURL falls back to
+// DefaultBaseURL.
+func NewClient(baseURL string, opts ...Option) (*Client, error) {
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("fastbound: invalid base URL: %w", err)
+	}
+
+	c := &Client{
+		baseURL:    u,
+		httpClient: http.DefaultClient,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	c.Transfers = &TransfersService{client: c}
+
+	return c, nil
+}
+
+// newRequest builds an *http.Request against the client's base URL and
+// applies the configured Authenticator, if any.
+func (c *Client) newRequest(ctx context.Context, method, path string, body io.Reader) (*http.Request, error) {
+	u := *c.baseURL
+	u.Path = strings.TrimRight(u.Path, "/") + "/" + strings.TrimLeft(path, "/")
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	if c.auth != nil {
+		if err := c.auth.Authenticate(req); err != nil {
+			return nil, fmt.Errorf("fastbound: authenticate request: %w", err)
+		}
+	}
+
+	return req, nil
+}
+
+// do sends req and, on a 2xx response, decodes the body into out (when out
+// is non-nil). Non-2xx responses are returned as an *APIError.
+func (c *Client) do(req *http.Request, out interface{}) (*http.Response, error) {
+	start := time.Now()
+	reqBody := c.peekRequestBody(req)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		c.recordAudit(req, reqBody, nil, nil, time.Since(start))
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		c.recordAudit(req, reqBody, resp, nil, time.Since(start))
+		return resp, fmt.Errorf("fastbound: read response: %w", err)
+	}
+	c.recordAudit(req, reqBody, resp, body, time.Since(start))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp, &APIError{StatusCode: resp.StatusCode, Body: body}
+	}
+
+	if out != nil && len(body) > 0 {
+		if err := json.Unmarshal(body, out); err != nil {
+			return resp, fmt.Errorf("fastbound: decode response: %w", err)
+		}
+	}
+
+	return resp, nil
+}
+
+// peekRequestBody reads req's body via GetBody (set automatically for the
+// in-memory bodies this client constructs) without consuming the copy that
+// will actually be sent.
+func (c *Client) peekRequestBody(req *http.Request) []byte {
+	if c.auditor == nil || req.GetBody == nil {
+		return nil
+	}
+	rc, err := req.GetBody()
+	if err != nil {
+		return nil
+	}
+	defer rc.Close()
+	body, err := io.ReadAll(rc)
+	if err != nil {
+		return nil
+	}
+	return body
+}
+
+// recordAudit reports an outbound API call to the configured
+// AuditRecorder, if any. Audit logging failures never fail the call.
+func (c *Client) recordAudit(req *http.Request, reqBody []byte, resp *http.Response, respBody []byte, latency time.Duration) {
+	if c.auditor == nil {
+		return
+	}
+
+	event := AuditEvent{
+		Method:         req.Method,
+		URL:            req.URL.String(),
+		Headers:        c.redactHeaders(req.Header),
+		RequestBody:    reqBody,
+		ResponseBody:   respBody,
+		Latency:        latency,
+		IdempotencyKey: extractIdempotencyKey(reqBody),
+	}
+	if resp != nil {
+		event.StatusCode = resp.StatusCode
+	}
+	if p, ok := c.auth.(principalAuthenticator); ok {
+		event.Principal = p.Principal()
+	}
+
+	_ = c.auditor.Record(event)
+}
+
+// redactHeaders returns a copy of h with credential-bearing headers
+// replaced. "Authorization" is always redacted; the configured
+// Authenticator can name additional headers via SensitiveHeaders (e.g.
+// APIKey with a custom header name).
+func (c *Client) redactHeaders(h http.Header) http.Header {
+	out := h.Clone()
+
+	sensitive := []string{"Authorization"}
+	if sh, ok := c.auth.(sensitiveHeaderAuthenticator); ok {
+		sensitive = append(sensitive, sh.SensitiveHeaders()...)
+	}
+
+	for _, name := range sensitive {
+		if out.Get(name) != "" {
+			out.Set(name, "REDACTED")
+		}
+	}
+	return out
+}
+
+func extractIdempotencyKey(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	var probe struct {
+		IdempotencyKey string `json:"idempotency_key"`
+	}
+	if err := json.Unmarshal(body, &probe); err != nil {
+		return ""
+	}
+	return probe.IdempotencyKey
+}
@@ -0,0 +1,167 @@
+package fastbound
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+type fakeAuditRecorder struct {
+	mu     sync.Mutex
+	events []AuditEvent
+}
+
+func (f *fakeAuditRecorder) Record(event AuditEvent) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.events = append(f.events, event)
+	return nil
+}
+
+func (f *fakeAuditRecorder) last() AuditEvent {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if len(f.events) == 0 {
+		return AuditEvent{}
+	}
+	return f.events[len(f.events)-1]
+}
+
+func TestNewClientDefaultsBaseURL(t *testing.T) {
+	c, err := NewClient("")
+	if err != nil {
+		t.Fatalf("NewClient(\"\") error = %v", err)
+	}
+	if c.baseURL.String() != DefaultBaseURL {
+		t.Errorf("baseURL = %q, want %q", c.baseURL.String(), DefaultBaseURL)
+	}
+}
+
+func TestNewClientInvalidURL(t *testing.T) {
+	if _, err := NewClient("://not-a-url"); err == nil {
+		t.Error("NewClient() error = nil, want error for an unparsable base URL")
+	}
+}
+
+func newTransferServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"id":"t1","status":"Pending"}`)
+	}))
+}
+
+func TestClientDoRedactsAuthorizationAndRecordsPrincipal(t *testing.T) {
+	srv := newTransferServer(t)
+	defer srv.Close()
+
+	recorder := &fakeAuditRecorder{}
+	client, err := NewClient(srv.URL,
+		WithAuthenticator(BasicAuth{Username: "alice", Password: "hunter2"}),
+		WithAuditRecorder(recorder),
+	)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	payload := TransferPayload{IdempotencyKey: "idem-1"}
+	if _, err := client.Transfers.Create(context.Background(), payload); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	event := recorder.last()
+	if got := event.Headers.Get("Authorization"); got != "REDACTED" {
+		t.Errorf("Headers[Authorization] = %q, want REDACTED", got)
+	}
+	wantBasic := "Basic " + base64.StdEncoding.EncodeToString([]byte("alice:hunter2"))
+	if strings.Contains(string(event.RequestBody), wantBasic) {
+		t.Error("RequestBody leaks the raw Basic auth credential")
+	}
+	if event.Principal != "alice" {
+		t.Errorf("Principal = %q, want alice", event.Principal)
+	}
+	if event.IdempotencyKey != "idem-1" {
+		t.Errorf("IdempotencyKey = %q, want idem-1", event.IdempotencyKey)
+	}
+	if event.StatusCode != http.StatusOK {
+		t.Errorf("StatusCode = %d, want 200", event.StatusCode)
+	}
+	if !strings.Contains(string(event.ResponseBody), `"id":"t1"`) {
+		t.Errorf("ResponseBody = %q, want it to contain the transfer id", event.ResponseBody)
+	}
+}
+
+func TestClientDoRedactsCustomAPIKeyHeader(t *testing.T) {
+	srv := newTransferServer(t)
+	defer srv.Close()
+
+	recorder := &fakeAuditRecorder{}
+	client, err := NewClient(srv.URL,
+		WithAuthenticator(APIKey{ID: "key-1", Key: "supersecret", Header: "X-Api-Key"}),
+		WithAuditRecorder(recorder),
+	)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	if _, err := client.Transfers.Create(context.Background(), TransferPayload{}); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	event := recorder.last()
+	if got := event.Headers.Get("X-Api-Key"); got != "REDACTED" {
+		t.Errorf("Headers[X-Api-Key] = %q, want REDACTED", got)
+	}
+	if event.Principal != "key-1" {
+		t.Errorf("Principal = %q, want key-1", event.Principal)
+	}
+}
+
+func TestClientDoRecordsAuditEventOnTransportError(t *testing.T) {
+	srv := newTransferServer(t)
+	srv.Close() // connections now fail outright
+
+	recorder := &fakeAuditRecorder{}
+	client, err := NewClient(srv.URL, WithAuditRecorder(recorder))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	if _, err := client.Transfers.Create(context.Background(), TransferPayload{}); err == nil {
+		t.Fatal("Create() error = nil, want a transport error")
+	}
+
+	event := recorder.last()
+	if event.StatusCode != 0 {
+		t.Errorf("StatusCode = %d, want 0 (no response was ever received)", event.StatusCode)
+	}
+	if event.Method != http.MethodPost {
+		t.Errorf("Method = %q, want POST", event.Method)
+	}
+}
+
+func TestExtractIdempotencyKey(t *testing.T) {
+	tests := []struct {
+		name string
+		body []byte
+		want string
+	}{
+		{"present", []byte(`{"idempotency_key":"abc123"}`), "abc123"},
+		{"absent", []byte(`{"other":"field"}`), ""},
+		{"empty body", nil, ""},
+		{"invalid json", []byte(`not-json`), ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := extractIdempotencyKey(tt.body); got != tt.want {
+				t.Errorf("extractIdempotencyKey(%s) = %q, want %q", tt.body, got, tt.want)
+			}
+		})
+	}
+}
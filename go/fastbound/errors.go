@@ -0,0 +1,13 @@
+package fastbound
+
+import "fmt"
+
+// APIError represents a non-2xx response from the FastBound API.
+type APIError struct {
+	StatusCode int
+	Body       []byte
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("fastbound: unexpected status %d: %s", e.StatusCode, e.Body)
+}
@@ -0,0 +1,210 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/FastBound/Support/go/fastbound"
+)
+
+// nfaTypes are Item.Type values regulated under the National Firearms Act.
+var nfaTypes = map[string]bool{
+	"Machine Gun":          true,
+	"Short-Barrel Rifle":   true,
+	"Short-Barrel Shotgun": true,
+	"Suppressor":           true,
+	"Destructive Device":   true,
+	"AOW":                  true,
+}
+
+// NonEmptySerial requires every item to carry a serial number.
+func NonEmptySerial() Rule {
+	return RuleFunc(func(payload fastbound.TransferPayload) []RuleViolation {
+		var violations []RuleViolation
+		for i, item := range payload.Items {
+			if item.Serial == "" {
+				violations = append(violations, RuleViolation{
+					Field:    fmt.Sprintf("items[%d].serial", i),
+					Code:     "serial_required",
+					Message:  "item serial number must not be empty",
+					Severity: SeverityError,
+				})
+			}
+		}
+		return violations
+	})
+}
+
+// ValidUPC requires every non-empty Item.UPC to carry a valid UPC-A check
+// digit.
+func ValidUPC() Rule {
+	return RuleFunc(func(payload fastbound.TransferPayload) []RuleViolation {
+		var violations []RuleViolation
+		for i, item := range payload.Items {
+			if item.UPC == "" {
+				continue
+			}
+			if !validUPCCheckDigit(item.UPC) {
+				violations = append(violations, RuleViolation{
+					Field:    fmt.Sprintf("items[%d].upc", i),
+					Code:     "invalid_upc_check_digit",
+					Message:  fmt.Sprintf("UPC %q fails the UPC-A check digit", item.UPC),
+					Severity: SeverityError,
+				})
+			}
+		}
+		return violations
+	})
+}
+
+func validUPCCheckDigit(upc string) bool {
+	if len(upc) != 12 {
+		return false
+	}
+
+	digits := make([]int, 12)
+	for i, c := range upc {
+		if c < '0' || c > '9' {
+			return false
+		}
+		digits[i] = int(c - '0')
+	}
+
+	sum := 0
+	for i := 0; i < 11; i++ {
+		if i%2 == 0 {
+			sum += digits[i] * 3
+		} else {
+			sum += digits[i]
+		}
+	}
+
+	return (10-sum%10)%10 == digits[11]
+}
+
+// BarrelLengthWithinOverall requires each item's barrel length not to
+// exceed its overall length.
+func BarrelLengthWithinOverall() Rule {
+	return RuleFunc(func(payload fastbound.TransferPayload) []RuleViolation {
+		var violations []RuleViolation
+		for i, item := range payload.Items {
+			if item.BarrelLength > item.OverallLength {
+				violations = append(violations, RuleViolation{
+					Field:    fmt.Sprintf("items[%d].barrelLength", i),
+					Code:     "barrel_exceeds_overall",
+					Message:  fmt.Sprintf("barrel length %.2f exceeds overall length %.2f", item.BarrelLength, item.OverallLength),
+					Severity: SeverityError,
+				})
+			}
+		}
+		return violations
+	})
+}
+
+// CountryImporterConsistency requires an importer whenever an item's
+// country of manufacture is not "USA".
+func CountryImporterConsistency() Rule {
+	return RuleFunc(func(payload fastbound.TransferPayload) []RuleViolation {
+		var violations []RuleViolation
+		for i, item := range payload.Items {
+			if item.Country != "" && item.Country != "USA" && (item.Importer == nil || *item.Importer == "") {
+				violations = append(violations, RuleViolation{
+					Field:    fmt.Sprintf("items[%d].importer", i),
+					Code:     "importer_required",
+					Message:  fmt.Sprintf("item manufactured in %s requires an importer", item.Country),
+					Severity: SeverityError,
+				})
+			}
+		}
+		return violations
+	})
+}
+
+// NFATypeCoherence flags a transfer that mixes NFA-regulated items (e.g.
+// suppressors, short-barrel rifles) with non-NFA items, since the two are
+// logged in separate bound books and should ship as separate transfers.
+func NFATypeCoherence() Rule {
+	return RuleFunc(func(payload fastbound.TransferPayload) []RuleViolation {
+		var sawNFA, sawNonNFA bool
+		for _, item := range payload.Items {
+			if nfaTypes[item.Type] {
+				sawNFA = true
+			} else {
+				sawNonNFA = true
+			}
+		}
+		if sawNFA && sawNonNFA {
+			return []RuleViolation{{
+				Field:    "items",
+				Code:     "mixed_nfa_non_nfa",
+				Message:  "transfer mixes NFA-regulated items with non-NFA items; submit them as separate transfers",
+				Severity: SeverityError,
+			}}
+		}
+		return nil
+	})
+}
+
+// NoDuplicateSerials flags items within a single payload that share a
+// serial number.
+func NoDuplicateSerials() Rule {
+	return RuleFunc(func(payload fastbound.TransferPayload) []RuleViolation {
+		seen := make(map[string]int, len(payload.Items))
+		var violations []RuleViolation
+		for i, item := range payload.Items {
+			if item.Serial == "" {
+				continue
+			}
+			if first, ok := seen[item.Serial]; ok {
+				violations = append(violations, RuleViolation{
+					Field:    fmt.Sprintf("items[%d].serial", i),
+					Code:     "duplicate_serial",
+					Message:  fmt.Sprintf("serial %q also appears at items[%d]", item.Serial, first),
+					Severity: SeverityError,
+				})
+				continue
+			}
+			seen[item.Serial] = i
+		}
+		return violations
+	})
+}
+
+// AllowedAcquireTypes flags a payload whose AcquireType is not in the
+// given allow list.
+func AllowedAcquireTypes(allowed ...string) Rule {
+	allow := make(map[string]bool, len(allowed))
+	for _, a := range allowed {
+		allow[a] = true
+	}
+	return RuleFunc(func(payload fastbound.TransferPayload) []RuleViolation {
+		if len(allow) > 0 && !allow[payload.AcquireType] {
+			return []RuleViolation{{
+				Field:    "acquire_type",
+				Code:     "acquire_type_not_allowed",
+				Message:  fmt.Sprintf("acquire type %q is not in the allowed list", payload.AcquireType),
+				Severity: SeverityError,
+			}}
+		}
+		return nil
+	})
+}
+
+// DeniedAcquireTypes flags a payload whose AcquireType is in the given
+// deny list.
+func DeniedAcquireTypes(denied ...string) Rule {
+	deny := make(map[string]bool, len(denied))
+	for _, d := range denied {
+		deny[d] = true
+	}
+	return RuleFunc(func(payload fastbound.TransferPayload) []RuleViolation {
+		if deny[payload.AcquireType] {
+			return []RuleViolation{{
+				Field:    "acquire_type",
+				Code:     "acquire_type_denied",
+				Message:  fmt.Sprintf("acquire type %q is denied by policy", payload.AcquireType),
+				Severity: SeverityError,
+			}}
+		}
+		return nil
+	})
+}
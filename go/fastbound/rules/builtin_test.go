@@ -0,0 +1,138 @@
+package rules
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/FastBound/Support/go/fastbound"
+)
+
+func strPtr(s string) *string { return &s }
+
+func TestValidUPCCheckDigit(t *testing.T) {
+	tests := []struct {
+		upc  string
+		want bool
+	}{
+		{"036000291452", true},  // real UPC-A, valid check digit
+		{"036000291453", false}, // check digit flipped
+		{"123456789011", false}, // check digit off by one
+		{"12345", false},        // wrong length
+		{"12345678901x", false}, // non-digit
+	}
+
+	for _, tt := range tests {
+		if got := validUPCCheckDigit(tt.upc); got != tt.want {
+			t.Errorf("validUPCCheckDigit(%q) = %v, want %v", tt.upc, got, tt.want)
+		}
+	}
+}
+
+func TestValidUPCRuleSkipsEmpty(t *testing.T) {
+	payload := fastbound.TransferPayload{Items: []fastbound.Item{{UPC: ""}}}
+	if violations := ValidUPC().Check(payload); len(violations) != 0 {
+		t.Errorf("ValidUPC().Check() with empty UPC = %v, want no violations", violations)
+	}
+}
+
+func TestBarrelLengthWithinOverall(t *testing.T) {
+	payload := fastbound.TransferPayload{Items: []fastbound.Item{
+		{BarrelLength: 16, OverallLength: 26},
+		{BarrelLength: 10, OverallLength: 8},
+	}}
+
+	violations := BarrelLengthWithinOverall().Check(payload)
+	if len(violations) != 1 {
+		t.Fatalf("got %d violations, want 1: %v", len(violations), violations)
+	}
+	if violations[0].Field != "items[1].barrelLength" {
+		t.Errorf("violation field = %q, want items[1].barrelLength", violations[0].Field)
+	}
+}
+
+func TestCountryImporterConsistency(t *testing.T) {
+	payload := fastbound.TransferPayload{Items: []fastbound.Item{
+		{Country: "USA"},
+		{Country: "Austria", Importer: nil},
+		{Country: "Austria", Importer: strPtr("Importer Co.")},
+	}}
+
+	violations := CountryImporterConsistency().Check(payload)
+	if len(violations) != 1 {
+		t.Fatalf("got %d violations, want 1: %v", len(violations), violations)
+	}
+	if violations[0].Field != "items[1].importer" {
+		t.Errorf("violation field = %q, want items[1].importer", violations[0].Field)
+	}
+}
+
+func TestNFATypeCoherence(t *testing.T) {
+	mixed := fastbound.TransferPayload{Items: []fastbound.Item{
+		{Type: "Pistol"},
+		{Type: "Suppressor"},
+	}}
+	if violations := NFATypeCoherence().Check(mixed); len(violations) != 1 {
+		t.Errorf("mixed payload: got %d violations, want 1", len(violations))
+	}
+
+	allNFA := fastbound.TransferPayload{Items: []fastbound.Item{
+		{Type: "Suppressor"},
+		{Type: "Machine Gun"},
+	}}
+	if violations := NFATypeCoherence().Check(allNFA); len(violations) != 0 {
+		t.Errorf("all-NFA payload: got %d violations, want 0: %v", len(violations), violations)
+	}
+}
+
+func TestNoDuplicateSerials(t *testing.T) {
+	payload := fastbound.TransferPayload{Items: []fastbound.Item{
+		{Serial: "ABC123"},
+		{Serial: "XYZ789"},
+		{Serial: "ABC123"},
+	}}
+
+	violations := NoDuplicateSerials().Check(payload)
+	if len(violations) != 1 {
+		t.Fatalf("got %d violations, want 1: %v", len(violations), violations)
+	}
+	if violations[0].Field != "items[2].serial" {
+		t.Errorf("violation field = %q, want items[2].serial", violations[0].Field)
+	}
+}
+
+func TestAllowedAndDeniedAcquireTypes(t *testing.T) {
+	payload := fastbound.TransferPayload{AcquireType: "Purchase"}
+
+	if violations := AllowedAcquireTypes("Purchase", "Consignment").Check(payload); len(violations) != 0 {
+		t.Errorf("allowed type: got %d violations, want 0: %v", len(violations), violations)
+	}
+	if violations := AllowedAcquireTypes("Consignment").Check(payload); len(violations) != 1 {
+		t.Errorf("disallowed type: got %d violations, want 1", len(violations))
+	}
+	if violations := DeniedAcquireTypes("Purchase").Check(payload); len(violations) != 1 {
+		t.Errorf("denied type: got %d violations, want 1", len(violations))
+	}
+	if violations := DeniedAcquireTypes("Consignment").Check(payload); len(violations) != 0 {
+		t.Errorf("non-denied type: got %d violations, want 0: %v", len(violations), violations)
+	}
+}
+
+func TestRuleSetValidateShortCircuitsOnError(t *testing.T) {
+	rs := NewRuleSet(NonEmptySerial())
+
+	err := rs.Validate(fastbound.TransferPayload{Items: []fastbound.Item{{Serial: ""}}})
+	if err == nil {
+		t.Fatal("Validate() error = nil, want *MultiError")
+	}
+	var multiErr *MultiError
+	if !errors.As(err, &multiErr) {
+		t.Fatalf("Validate() error = %T, want *MultiError", err)
+	}
+	if len(multiErr.Violations) != 1 {
+		t.Errorf("MultiError has %d violations, want 1", len(multiErr.Violations))
+	}
+
+	if err := rs.Validate(fastbound.TransferPayload{Items: []fastbound.Item{{Serial: "ABC123"}}}); err != nil {
+		t.Errorf("Validate() error = %v, want nil", err)
+	}
+}
@@ -0,0 +1,98 @@
+// Package rules provides a pre-submission validation engine for
+// fastbound.TransferPayload, so ATF/A&D policy checks run before a payload
+// is marshalled and sent.
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/FastBound/Support/go/fastbound"
+)
+
+// Severity classifies a RuleViolation as blocking or advisory.
+type Severity string
+
+// Severity levels.
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// RuleViolation describes one failed check against a TransferPayload.
+type RuleViolation struct {
+	Field    string
+	Code     string
+	Message  string
+	Severity Severity
+}
+
+// Rule checks a TransferPayload and returns any violations it finds.
+type Rule interface {
+	Check(payload fastbound.TransferPayload) []RuleViolation
+}
+
+// RuleFunc adapts a plain function to the Rule interface.
+type RuleFunc func(payload fastbound.TransferPayload) []RuleViolation
+
+// Check implements Rule.
+func (f RuleFunc) Check(payload fastbound.TransferPayload) []RuleViolation {
+	return f(payload)
+}
+
+// RuleSet runs a collection of Rules against a TransferPayload and
+// implements fastbound.Validator, so it can be installed on a Client via
+// fastbound.WithValidator.
+type RuleSet struct {
+	rules []Rule
+}
+
+// NewRuleSet constructs a RuleSet from the given rules.
+func NewRuleSet(rules ...Rule) *RuleSet {
+	return &RuleSet{rules: append([]Rule{}, rules...)}
+}
+
+// Register adds a rule to the set, so integrators can layer on
+// site-specific policies.
+func (rs *RuleSet) Register(r Rule) {
+	rs.rules = append(rs.rules, r)
+}
+
+// Check runs every registered rule and returns all violations found,
+// across all severities.
+func (rs *RuleSet) Check(payload fastbound.TransferPayload) []RuleViolation {
+	var violations []RuleViolation
+	for _, r := range rs.rules {
+		violations = append(violations, r.Check(payload)...)
+	}
+	return violations
+}
+
+// Validate implements fastbound.Validator. It returns a *MultiError if any
+// violation has SeverityError; SeverityWarning violations are checked but
+// never block submission.
+func (rs *RuleSet) Validate(payload fastbound.TransferPayload) error {
+	var errViolations []RuleViolation
+	for _, v := range rs.Check(payload) {
+		if v.Severity == SeverityError {
+			errViolations = append(errViolations, v)
+		}
+	}
+	if len(errViolations) == 0 {
+		return nil
+	}
+	return &MultiError{Violations: errViolations}
+}
+
+// MultiError aggregates one or more SeverityError RuleViolations.
+type MultiError struct {
+	Violations []RuleViolation
+}
+
+func (e *MultiError) Error() string {
+	msgs := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		msgs[i] = fmt.Sprintf("%s: %s (%s)", v.Field, v.Message, v.Code)
+	}
+	return fmt.Sprintf("rules: %d violation(s): %s", len(e.Violations), strings.Join(msgs, "; "))
+}
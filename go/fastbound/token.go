@@ -0,0 +1,28 @@
+package fastbound
+
+import "time"
+
+// Token is a pair of OAuth 2.0 access and refresh tokens.
+type Token struct {
+	AccessToken  string    `json:"access_token"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+	TokenType    string    `json:"token_type,omitempty"`
+	ExpiresAt    time.Time `json:"expires_at"`
+}
+
+// Valid reports whether the access token is present and not yet expired.
+// A small skew is applied so callers refresh slightly ahead of the actual
+// expiry rather than racing it.
+func (t Token) Valid() bool {
+	if t.AccessToken == "" {
+		return false
+	}
+	return time.Now().Before(t.ExpiresAt.Add(-30 * time.Second))
+}
+
+// TokenStore persists a Token between process runs so long-running
+// importers do not need to re-authenticate on every start.
+type TokenStore interface {
+	Load() (*Token, error)
+	Save(Token) error
+}
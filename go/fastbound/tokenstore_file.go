@@ -0,0 +1,48 @@
+package fastbound
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// FileTokenStore persists a Token as JSON at Path, chmod'd to 0600 since it
+// contains live credentials.
+type FileTokenStore struct {
+	Path string
+}
+
+// Load reads the token from Path. It returns (nil, nil) if the file does
+// not exist yet.
+func (f *FileTokenStore) Load() (*Token, error) {
+	data, err := os.ReadFile(f.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("fastbound: read token store: %w", err)
+	}
+
+	var t Token
+	if err := json.Unmarshal(data, &t); err != nil {
+		return nil, fmt.Errorf("fastbound: decode token store: %w", err)
+	}
+	return &t, nil
+}
+
+// Save writes t to Path as JSON, creating or truncating the file with
+// 0600 permissions.
+func (f *FileTokenStore) Save(t Token) error {
+	data, err := json.Marshal(t)
+	if err != nil {
+		return fmt.Errorf("fastbound: encode token store: %w", err)
+	}
+
+	if err := os.WriteFile(f.Path, data, 0o600); err != nil {
+		return fmt.Errorf("fastbound: write token store: %w", err)
+	}
+	// os.WriteFile only applies the mode to newly created files; make sure
+	// an existing, more permissive file is tightened up too.
+	return os.Chmod(f.Path, 0o600)
+}
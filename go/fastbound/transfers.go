@@ -0,0 +1,101 @@
+package fastbound
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// TransfersService provides access to the transfers resource of the
+// FastBound API.
+type TransfersService struct {
+	client *Client
+}
+
+// Item describes a single firearm or item within a TransferPayload.
+type Item struct {
+	Manufacturer  string  `json:"manufacturer"`
+	Importer      *string `json:"importer"`
+	Country       string  `json:"country"`
+	Model         string  `json:"model"`
+	Caliber       string  `json:"caliber"`
+	Type          string  `json:"type"`
+	Serial        string  `json:"serial"`
+	SKU           string  `json:"sku"`
+	MPN           string  `json:"mpn"`
+	UPC           string  `json:"upc"`
+	BarrelLength  float64 `json:"barrelLength"`
+	OverallLength float64 `json:"overallLength"`
+	Cost          float64 `json:"cost"`
+	Price         float64 `json:"price"`
+	Condition     string  `json:"condition"`
+	Note          string  `json:"note"`
+}
+
+// TransferPayload is the request body for creating a transfer.
+type TransferPayload struct {
+	Schema           string   `json:"$schema"`
+	IdempotencyKey   string   `json:"idempotency_key"`
+	Transferor       string   `json:"transferor"`
+	Transferee       string   `json:"transferee"`
+	TransfereeEmails []string `json:"transferee_emails"`
+	TrackingNumber   string   `json:"tracking_number"`
+	PoNumber         string   `json:"po_number"`
+	InvoiceNumber    string   `json:"invoice_number"`
+	AcquireType      string   `json:"acquire_type"`
+	Note             string   `json:"note"`
+	Items            []Item   `json:"items"`
+}
+
+// TransferResponse is returned by a successful call to Create.
+type TransferResponse struct {
+	ID             string        `json:"id"`
+	IdempotencyKey string        `json:"idempotency_key"`
+	Status         TransferState `json:"status"`
+}
+
+// Validator runs pre-submission policy checks against a TransferPayload,
+// returning a non-nil error to block Create. The rules subpackage provides
+// a RuleSet implementation.
+type Validator interface {
+	Validate(TransferPayload) error
+}
+
+// Create validates payload (if the client was constructed with
+// WithValidator) and submits a new transfer.
+func (s *TransfersService) Create(ctx context.Context, payload TransferPayload) (*TransferResponse, error) {
+	if s.client.validator != nil {
+		if err := s.client.validator.Validate(payload); err != nil {
+			return nil, err
+		}
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("fastbound: marshal transfer payload: %w", err)
+	}
+
+	req, err := s.client.newRequest(ctx, http.MethodPost, "/api/transfers", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	var out TransferResponse
+	if _, err := s.client.do(req, &out); err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
+
+// GenerateIdempotencyKey derives a stable idempotency key from the given
+// fields, so repeated submissions of the same logical transfer are safe to
+// retry.
+func GenerateIdempotencyKey(fields ...string) string {
+	hash := sha256.Sum256([]byte(strings.Join(fields, "\n")))
+	return fmt.Sprintf("%x", hash)
+}
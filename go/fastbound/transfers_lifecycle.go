@@ -0,0 +1,212 @@
+package fastbound
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"net/http"
+	"time"
+)
+
+// TransferState is a transfer's position in its acquisition/disposition
+// lifecycle: Pending -> Accepted|Rejected|Cancelled, and an Accepted
+// transfer may later move to Refunding -> Refunded|RefundFailed.
+type TransferState string
+
+// Transfer lifecycle states.
+const (
+	StatePending      TransferState = "Pending"
+	StateAccepted     TransferState = "Accepted"
+	StateRejected     TransferState = "Rejected"
+	StateCancelled    TransferState = "Cancelled"
+	StateRefunding    TransferState = "Refunding"
+	StateRefunded     TransferState = "Refunded"
+	StateRefundFailed TransferState = "RefundFailed"
+)
+
+// IsTerminal reports whether s has no further automatic transitions, i.e.
+// SubmitAndWait should stop polling.
+func (s TransferState) IsTerminal() bool {
+	switch s {
+	case StateAccepted, StateRejected, StateCancelled, StateRefunded, StateRefundFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// Errors returned by Refund.
+var (
+	ErrNotRefundable = errors.New("fastbound: transfer is not in a refundable state")
+	ErrRefundFailed  = errors.New("fastbound: refund failed")
+)
+
+// PollOptions controls the retry and polling behavior of SubmitAndWait.
+type PollOptions struct {
+	// Interval is the delay between status polls. Defaults to 2s.
+	Interval time.Duration
+	// Timeout bounds the total time spent polling for a terminal state.
+	// Zero means poll indefinitely.
+	Timeout time.Duration
+	// MaxRetries bounds resubmission attempts on retryable errors.
+	// Defaults to 5.
+	MaxRetries int
+	// InitialBackoff is the delay before the first retry. Defaults to
+	// 500ms and doubles on each subsequent retry.
+	InitialBackoff time.Duration
+}
+
+func (o *PollOptions) withDefaults() {
+	if o.Interval <= 0 {
+		o.Interval = 2 * time.Second
+	}
+	if o.MaxRetries <= 0 {
+		o.MaxRetries = 5
+	}
+	if o.InitialBackoff <= 0 {
+		o.InitialBackoff = 500 * time.Millisecond
+	}
+}
+
+// TransferResult is the outcome of SubmitAndWait: the transfer in whatever
+// terminal state it reached.
+type TransferResult struct {
+	*TransferResponse
+}
+
+// Get fetches the current state of a previously submitted transfer.
+func (s *TransfersService) Get(ctx context.Context, id string) (*TransferResponse, error) {
+	req, err := s.client.newRequest(ctx, http.MethodGet, "/api/transfers/"+id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var out TransferResponse
+	if _, err := s.client.do(req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SubmitAndWait submits payload, resending it with the same
+// idempotency_key on retryable errors, then polls the transfer until it
+// reaches a terminal TransferState.
+func (s *TransfersService) SubmitAndWait(ctx context.Context, payload TransferPayload, opts PollOptions) (*TransferResult, error) {
+	opts.withDefaults()
+
+	resp, err := s.submitWithRetry(ctx, payload, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.waitForTerminal(ctx, resp.ID, opts)
+}
+
+func (s *TransfersService) submitWithRetry(ctx context.Context, payload TransferPayload, opts PollOptions) (*TransferResponse, error) {
+	backoff := opts.InitialBackoff
+
+	var lastErr error
+	for attempt := 0; attempt <= opts.MaxRetries; attempt++ {
+		resp, err := s.Create(ctx, payload)
+		if err == nil {
+			return resp, nil
+		}
+		lastErr = err
+
+		if !isRetryableError(err) || attempt == opts.MaxRetries {
+			return nil, err
+		}
+
+		wait := backoff + time.Duration(rand.Int63n(int64(backoff)+1))
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+		backoff *= 2
+	}
+
+	return nil, fmt.Errorf("fastbound: submit transfer: exhausted retries: %w", lastErr)
+}
+
+func (s *TransfersService) waitForTerminal(ctx context.Context, id string, opts PollOptions) (*TransferResult, error) {
+	var deadline time.Time
+	if opts.Timeout > 0 {
+		deadline = time.Now().Add(opts.Timeout)
+	}
+
+	for {
+		resp, err := s.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if resp.Status.IsTerminal() {
+			return &TransferResult{TransferResponse: resp}, nil
+		}
+
+		if !deadline.IsZero() && time.Now().After(deadline) {
+			return nil, fmt.Errorf("fastbound: timed out waiting for transfer %s to reach a terminal state (last state %s)", id, resp.Status)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(opts.Interval):
+		}
+	}
+}
+
+// isRetryableError reports whether err is worth resubmitting: a 5xx
+// APIError, or a transport-level error (a *net.OpError, *url.Error, or
+// similar net.Error). Validation errors (e.g. rules.MultiError), JSON
+// marshal errors, and Authenticator failures are deterministic and are
+// never retryable.
+func isRetryableError(err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode >= 500
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// refundRequest is the request body for Refund.
+type refundRequest struct {
+	Reason string `json:"reason"`
+}
+
+// Refund requests a refund of a previously accepted transfer, returning
+// ErrNotRefundable if the transfer isn't in a refundable state, or
+// ErrRefundFailed if the refund itself could not be processed.
+func (s *TransfersService) Refund(ctx context.Context, id, reason string) (*TransferResponse, error) {
+	body, err := json.Marshal(refundRequest{Reason: reason})
+	if err != nil {
+		return nil, fmt.Errorf("fastbound: marshal refund request: %w", err)
+	}
+
+	req, err := s.client.newRequest(ctx, http.MethodPost, "/api/transfers/"+id+"/refund", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	var out TransferResponse
+	if _, err := s.client.do(req, &out); err != nil {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) {
+			switch apiErr.StatusCode {
+			case http.StatusConflict:
+				return nil, ErrNotRefundable
+			case http.StatusUnprocessableEntity:
+				return nil, ErrRefundFailed
+			}
+		}
+		return nil, err
+	}
+
+	return &out, nil
+}
@@ -0,0 +1,141 @@
+package fastbound
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type fakeNetError struct{}
+
+func (fakeNetError) Error() string   { return "fake network error" }
+func (fakeNetError) Timeout() bool   { return true }
+func (fakeNetError) Temporary() bool { return true }
+
+var _ net.Error = fakeNetError{}
+
+func TestIsRetryableError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"5xx APIError", &APIError{StatusCode: 503}, true},
+		{"4xx APIError", &APIError{StatusCode: 400}, false},
+		{"network error", fakeNetError{}, true},
+		{"validation error", errors.New("serial is required"), false},
+		{"wrapped network error", fmt.Errorf("do request: %w", fakeNetError{}), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isRetryableError(tt.err); got != tt.want {
+				t.Errorf("isRetryableError(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSubmitAndWaitRetriesOnServerError(t *testing.T) {
+	var createAttempts int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/transfers", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&createAttempts, 1) <= 2 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"id":"t1","status":"Pending"}`)
+	})
+	mux.HandleFunc("/api/transfers/t1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":"t1","status":"Accepted"}`)
+	})
+
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	client, err := NewClient(srv.URL)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	result, err := client.Transfers.SubmitAndWait(context.Background(), TransferPayload{}, PollOptions{
+		Interval:       time.Millisecond,
+		InitialBackoff: time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("SubmitAndWait() error = %v", err)
+	}
+	if result.Status != StateAccepted {
+		t.Errorf("result.Status = %q, want %q", result.Status, StateAccepted)
+	}
+	if got := atomic.LoadInt32(&createAttempts); got != 3 {
+		t.Errorf("createAttempts = %d, want 3", got)
+	}
+}
+
+func TestSubmitAndWaitFailsFastOnValidationError(t *testing.T) {
+	var createAttempts int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/transfers", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&createAttempts, 1)
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"error":"invalid payload"}`)
+	})
+
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	client, err := NewClient(srv.URL)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	_, err = client.Transfers.SubmitAndWait(context.Background(), TransferPayload{}, PollOptions{
+		Interval:       time.Millisecond,
+		InitialBackoff: time.Millisecond,
+	})
+	if err == nil {
+		t.Fatal("SubmitAndWait() error = nil, want non-nil")
+	}
+	if got := atomic.LoadInt32(&createAttempts); got != 1 {
+		t.Errorf("createAttempts = %d, want 1 (no retries on a non-retryable error)", got)
+	}
+}
+
+func TestRefundErrorMapping(t *testing.T) {
+	tests := []struct {
+		status  int
+		wantErr error
+	}{
+		{http.StatusConflict, ErrNotRefundable},
+		{http.StatusUnprocessableEntity, ErrRefundFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("status_%d", tt.status), func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+			}))
+			defer srv.Close()
+
+			client, err := NewClient(srv.URL)
+			if err != nil {
+				t.Fatalf("NewClient() error = %v", err)
+			}
+
+			_, err = client.Transfers.Refund(context.Background(), "t1", "damaged in transit")
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Refund() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
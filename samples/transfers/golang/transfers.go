@@ -1,86 +1,32 @@
+// Command transfers demonstrates submitting a transfer to the FastBound API
+// using the github.com/FastBound/Support/go/fastbound client package.
 package main
 
 import (
-	"bytes"
-	"crypto/sha256"
-	"encoding/base64"
-	"encoding/json"
+	"context"
 	"fmt"
-	"io/ioutil"
-	"net/http"
-	"strings"
+	"os"
 	"time"
+
+	"github.com/FastBound/Support/go/fastbound"
 )
 
 const (
 	USERNAME = "YOUR_USERNAME"
 	PASSWORD = "YOUR_PASSWORD"
-	API_URL  = "https://cloud.fastbound.com/api/transfers"
+	API_URL  = "https://cloud.fastbound.com"
 )
 
-type Item struct {
-	Manufacturer  string  `json:"manufacturer"`
-	Importer      *string `json:"importer"`
-	Country       string  `json:"country"`
-	Model         string  `json:"model"`
-	Caliber       string  `json:"caliber"`
-	Type          string  `json:"type"`
-	Serial        string  `json:"serial"`
-	SKU           string  `json:"sku"`
-	MPN           string  `json:"mpn"`
-	UPC           string  `json:"upc"`
-	BarrelLength  float64 `json:"barrelLength"`
-	OverallLength float64 `json:"overallLength"`
-	Cost          float64 `json:"cost"`
-	Price         float64 `json:"price"`
-	Condition     string  `json:"condition"`
-	Note          string  `json:"note"`
-}
-
-type TransferPayload struct {
-	Schema           string   `json:"$schema"`
-	IdempotencyKey   string   `json:"idempotency_key"`
-	Transferor       string   `json:"transferor"`
-	Transferee       string   `json:"transferee"`
-	TransfereeEmails []string `json:"transferee_emails"`
-	TrackingNumber   string   `json:"tracking_number"`
-	PoNumber         string   `json:"po_number"`
-	InvoiceNumber    string   `json:"invoice_number"`
-	AcquireType      string   `json:"acquire_type"`
-	Note             string   `json:"note"`
-	Items            []Item   `json:"items"`
-}
-
-func generateIdempotencyKey(data []string) string {
-	hash := sha256.Sum256([]byte(strings.Join(data, "\n")))
-	return fmt.Sprintf("%x", hash)
-}
-
-func sendPostRequest(jsonPayload []byte) {
-	authString := base64.StdEncoding.EncodeToString([]byte(USERNAME + ":" + PASSWORD))
-
-	req, err := http.NewRequest("POST", API_URL, bytes.NewBuffer(jsonPayload))
-	if err != nil {
-		fmt.Println("Error creating request:", err)
-		return
-	}
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", "Basic "+authString)
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
+func main() {
+	client, err := fastbound.NewClient(API_URL, fastbound.WithAuthenticator(fastbound.BasicAuth{
+		Username: USERNAME,
+		Password: PASSWORD,
+	}))
 	if err != nil {
-		fmt.Println("Error sending request:", err)
-		return
+		fmt.Println("Error creating client:", err)
+		os.Exit(1)
 	}
-	defer resp.Body.Close()
 
-	body, _ := ioutil.ReadAll(resp.Body)
-	fmt.Printf("HTTP Code: %d\n", resp.StatusCode)
-	fmt.Println("Response:", string(body))
-}
-
-func main() {
 	shipmentDate := time.Now().Format("2006-01-02")
 
 	transferor := "1-23-456-78-9A-12345"
@@ -89,19 +35,31 @@ func main() {
 	poNumber := "PO123456"
 	invoiceNumber := "INV98765"
 
-	items := []Item{
-		{"Glock", nil, "Austria", "G17", "9mm", "Pistol", "ABC123456", "GLK-G17", "G17MPN", "123456789012", 4.48, 8.03, 500.00, 650.00, "New", "Brand new firearm"},
-		{"Smith & Wesson", nil, "USA", "M&P Shield", "9mm", "Pistol", "XYZ987654", "S&W-SHIELD", "SHIELDMPN", "987654321098", 3.1, 6.1, 450.00, 600.00, "New", "Compact pistol"},
+	items := []fastbound.Item{
+		{
+			Manufacturer: "Glock", Country: "Austria", Model: "G17", Caliber: "9mm", Type: "Pistol",
+			Serial: "ABC123456", SKU: "GLK-G17", MPN: "G17MPN", UPC: "123456789012",
+			BarrelLength: 4.48, OverallLength: 8.03, Cost: 500.00, Price: 650.00,
+			Condition: "New", Note: "Brand new firearm",
+		},
+		{
+			Manufacturer: "Smith & Wesson", Country: "USA", Model: "M&P Shield", Caliber: "9mm", Type: "Pistol",
+			Serial: "XYZ987654", SKU: "S&W-SHIELD", MPN: "SHIELDMPN", UPC: "987654321098",
+			BarrelLength: 3.1, OverallLength: 6.1, Cost: 450.00, Price: 600.00,
+			Condition: "New", Note: "Compact pistol",
+		},
 	}
 
-	serialNumbers := []string{}
+	serialNumbers := make([]string, 0, len(items))
 	for _, item := range items {
 		serialNumbers = append(serialNumbers, item.Serial)
 	}
 
-	idempotencyKey := generateIdempotencyKey(append([]string{shipmentDate, transferor, transferee, trackingNumber, poNumber, invoiceNumber}, serialNumbers...))
+	idempotencyKey := fastbound.GenerateIdempotencyKey(
+		append([]string{shipmentDate, transferor, transferee, trackingNumber, poNumber, invoiceNumber}, serialNumbers...)...,
+	)
 
-	payload := TransferPayload{
+	payload := fastbound.TransferPayload{
 		Schema:           "https://schemas.fastbound.org/transfers-push-v1.json",
 		IdempotencyKey:   idempotencyKey,
 		Transferor:       transferor,
@@ -115,11 +73,11 @@ func main() {
 		Items:            items,
 	}
 
-	jsonPayload, err := json.MarshalIndent(payload, "", "  ")
+	resp, err := client.Transfers.Create(context.Background(), payload)
 	if err != nil {
-		fmt.Println("Error marshalling JSON:", err)
-		return
+		fmt.Println("Error creating transfer:", err)
+		os.Exit(1)
 	}
 
-	sendPostRequest(jsonPayload)
+	fmt.Printf("HTTP OK, transfer %s status: %s\n", resp.ID, resp.Status)
 }